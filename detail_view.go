@@ -0,0 +1,185 @@
+package main
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/dahead/space/internal/format"
+)
+
+// dirEntry is one immediate child of the mount point being drilled into,
+// with its recursive size already summed.
+type dirEntry struct {
+	Name  string
+	Size  int64
+	IsDir bool
+}
+
+// detailModel drives the du-style directory size view entered with enter
+// on a list row.
+type detailModel struct {
+	mountPoint string
+	entries    []dirEntry
+	total      int64
+	cursor     int
+	loading    bool
+	err        error
+}
+
+// dirEntriesMsg carries the result of walking a mount point's top level.
+type dirEntriesMsg struct {
+	entries []dirEntry
+	total   int64
+}
+
+// newDetailModel starts a detail view loading the given mount point's
+// immediate children sizes.
+func newDetailModel(mountPoint string) (*detailModel, tea.Cmd) {
+	return &detailModel{mountPoint: mountPoint, loading: true}, walkDirCmd(mountPoint)
+}
+
+// walkDirCmd sums the size of every immediate child of root, recursing into
+// subdirectories, mirroring what a `du -d1` style tool reports.
+func walkDirCmd(root string) tea.Cmd {
+	return func() tea.Msg {
+		children, err := os.ReadDir(root)
+		if err != nil {
+			return err
+		}
+
+		var entries []dirEntry
+		var total int64
+		for _, c := range children {
+			info, err := c.Info()
+			if err != nil {
+				continue
+			}
+
+			childPath := filepath.Join(root, c.Name())
+			var size int64
+			if c.IsDir() {
+				size = dirSize(childPath)
+			} else {
+				size = info.Size()
+			}
+
+			entries = append(entries, dirEntry{Name: c.Name(), Size: size, IsDir: c.IsDir()})
+			total += size
+		}
+
+		sort.Slice(entries, func(i, j int) bool { return entries[i].Size > entries[j].Size })
+
+		return dirEntriesMsg{entries: entries, total: total}
+	}
+}
+
+// dirSize recursively sums the size of every non-directory entry under root
+// (a symlink counts as the link itself, not its target), skipping entries it
+// can't read or stat (permission errors, entries removed mid-walk, ...).
+func dirSize(root string) int64 {
+	var total int64
+	_ = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return nil
+		}
+		if info, err := d.Info(); err == nil {
+			total += info.Size()
+		}
+		return nil
+	})
+	return total
+}
+
+// updateDetail handles key events while viewDetail is active.
+func (m Model) updateDetail(msg tea.Msg) (tea.Model, tea.Cmd) {
+	d := m.detail
+	switch msg := msg.(type) {
+	case dirEntriesMsg:
+		d.entries = msg.entries
+		d.total = msg.total
+		d.loading = false
+		return m, nil
+	case error:
+		d.err = msg
+		d.loading = false
+		return m, nil
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "esc", "backspace", "q":
+			m.state = viewList
+			m.detail = nil
+			return m, nil
+		case "?":
+			m.prevState = m.state
+			m.state = viewHelp
+			return m, nil
+		case "up", "k":
+			if d.cursor > 0 {
+				d.cursor--
+			}
+		case "down", "j":
+			if d.cursor < len(d.entries)-1 {
+				d.cursor++
+			}
+		case "ctrl+c":
+			return m, tea.Quit
+		}
+	}
+	return m, nil
+}
+
+// renderDetail draws the du-style breakdown of the selected mount point.
+func (m Model) renderDetail() string {
+	d := m.detail
+	nameStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#89B4FA"))
+	barStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#F38BA8"))
+	cursorStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#A6E3A1")).Bold(true)
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%s\n\n", nameStyle.Render(d.mountPoint)))
+
+	if d.err != nil {
+		sb.WriteString(fmt.Sprintf("Error: %v\n", d.err))
+		return sb.String()
+	}
+	if d.loading {
+		sb.WriteString("Scanning...\n")
+		return sb.String()
+	}
+	if len(d.entries) == 0 {
+		sb.WriteString("(empty)\n")
+		return sb.String()
+	}
+
+	const barWidth = 30
+	for i, e := range d.entries {
+		cursor := "  "
+		if i == d.cursor {
+			cursor = cursorStyle.Render("> ")
+		}
+
+		var frac float64
+		if d.total > 0 {
+			frac = float64(e.Size) / float64(d.total)
+		}
+		filled := int(frac * float64(barWidth))
+		bar := barStyle.Render(strings.Repeat("█", filled)) + strings.Repeat("░", barWidth-filled)
+
+		name := e.Name
+		if e.IsDir {
+			name += "/"
+		}
+
+		sb.WriteString(fmt.Sprintf("%s[%s] %10s  %s\n", cursor, bar, format.FormatBytes(uint64(e.Size), m.units), name))
+	}
+
+	sb.WriteString(fmt.Sprintf("\nTotal: %s  (esc to go back)\n", format.FormatBytes(uint64(d.total), m.units)))
+	return sb.String()
+}
@@ -0,0 +1,41 @@
+// Package diskprobe enumerates mounted filesystems and reports their
+// capacity natively, without shelling out to platform utilities like df.
+package diskprobe
+
+// DiskInfo describes a single mounted filesystem.
+type DiskInfo struct {
+	Device      string `json:"device"`
+	MountPoint  string `json:"mountpoint"`
+	FSType      string `json:"fstype"`
+	Label       string `json:"label"`
+	Size        int64  `json:"size"`
+	Used        int64  `json:"used"`
+	Available   int64  `json:"available"`
+	UsePercent  int    `json:"used_percent"`
+	InodesTotal int64  `json:"inodes_total"`
+	InodesUsed  int64  `json:"inodes_used"`
+	InodesFree  int64  `json:"inodes_free"`
+	ReadOnly    bool   `json:"read_only"`
+}
+
+// Options controls what Probe includes in its result.
+type Options struct {
+	// IncludePseudo includes virtual filesystems (proc, sysfs, tmpfs, ...)
+	// that are hidden by default. Only the Linux prober filters by
+	// filesystem type; the darwin prober returns every mount and the
+	// Windows prober returns fixed and removable drives, whatever this is.
+	IncludePseudo bool
+}
+
+// Prober enumerates mounted filesystems on the host and reports their
+// capacity. Implementations are platform-specific; use New to obtain the
+// one appropriate for the running OS.
+type Prober interface {
+	// Probe returns the current set of mounted filesystems.
+	Probe(opts Options) ([]DiskInfo, error)
+}
+
+// New returns the Prober implementation for the running platform.
+func New() Prober {
+	return newPlatformProber()
+}
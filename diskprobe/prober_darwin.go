@@ -0,0 +1,75 @@
+//go:build darwin
+
+package diskprobe
+
+import (
+	"fmt"
+
+	"golang.org/x/sys/unix"
+)
+
+type darwinProber struct{}
+
+func newPlatformProber() Prober {
+	return darwinProber{}
+}
+
+// Probe calls getfsstat(2), the same syscall getmntinfo(3) wraps, to list
+// every mounted filesystem along with its statfs(2) capacity fields in a
+// single call.
+func (darwinProber) Probe(opts Options) ([]DiskInfo, error) {
+	n, err := unix.Getfsstat(nil, unix.MNT_NOWAIT)
+	if err != nil {
+		return nil, fmt.Errorf("diskprobe: getfsstat: %w", err)
+	}
+
+	buf := make([]unix.Statfs_t, n)
+	n, err = unix.Getfsstat(buf, unix.MNT_NOWAIT)
+	if err != nil {
+		return nil, fmt.Errorf("diskprobe: getfsstat: %w", err)
+	}
+	buf = buf[:n]
+
+	infos := make([]DiskInfo, 0, len(buf))
+	for _, stat := range buf {
+		bsize := int64(stat.Bsize)
+		size := int64(stat.Blocks) * bsize
+		free := int64(stat.Bfree) * bsize
+		avail := int64(stat.Bavail) * bsize
+		used := size - free
+
+		var usePercent int
+		if size > 0 {
+			usePercent = int(float64(used) / float64(size) * 100.0)
+		}
+
+		infos = append(infos, DiskInfo{
+			Device:      bytesToString(stat.Mntfromname[:]),
+			MountPoint:  bytesToString(stat.Mntonname[:]),
+			FSType:      bytesToString(stat.Fstypename[:]),
+			Size:        size,
+			Used:        used,
+			Available:   avail,
+			UsePercent:  usePercent,
+			InodesTotal: int64(stat.Files),
+			InodesUsed:  int64(stat.Files) - int64(stat.Ffree),
+			InodesFree:  int64(stat.Ffree),
+			ReadOnly:    stat.Flags&unix.MNT_RDONLY != 0,
+		})
+	}
+
+	return infos, nil
+}
+
+// bytesToString converts a NUL-padded fixed-size byte array, as used in the
+// darwin statfs struct, into a Go string.
+func bytesToString(b []byte) string {
+	buf := make([]byte, 0, len(b))
+	for _, c := range b {
+		if c == 0 {
+			break
+		}
+		buf = append(buf, c)
+	}
+	return string(buf)
+}
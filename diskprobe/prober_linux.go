@@ -0,0 +1,155 @@
+//go:build linux
+
+package diskprobe
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// pseudoFSTypes are filesystems that do not represent real storage and are
+// skipped during enumeration unless Options.IncludePseudo is set.
+var pseudoFSTypes = map[string]bool{
+	"proc":        true,
+	"sysfs":       true,
+	"tmpfs":       true,
+	"cgroup":      true,
+	"cgroup2":     true,
+	"devpts":      true,
+	"pstore":      true,
+	"securityfs":  true,
+	"debugfs":     true,
+	"tracefs":     true,
+	"configfs":    true,
+	"fusectl":     true,
+	"mqueue":      true,
+	"hugetlbfs":   true,
+	"bpf":         true,
+	"autofs":      true,
+	"binfmt_misc": true,
+}
+
+type linuxProber struct{}
+
+func newPlatformProber() Prober {
+	return linuxProber{}
+}
+
+// Probe walks /proc/mounts and statfs(2)s each listed filesystem.
+func (linuxProber) Probe(opts Options) ([]DiskInfo, error) {
+	f, err := os.Open("/proc/mounts")
+	if err != nil {
+		return nil, fmt.Errorf("diskprobe: open /proc/mounts: %w", err)
+	}
+	defer f.Close()
+
+	var infos []DiskInfo
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 4 {
+			continue
+		}
+		device, mountPoint, fsType := fields[0], unescapeMount(fields[1]), fields[2]
+		if pseudoFSTypes[fsType] && !opts.IncludePseudo {
+			continue
+		}
+
+		var stat syscall.Statfs_t
+		if err := syscall.Statfs(mountPoint, &stat); err != nil {
+			continue
+		}
+
+		bsize := int64(stat.Bsize)
+		size := int64(stat.Blocks) * bsize
+		free := int64(stat.Bfree) * bsize
+		avail := int64(stat.Bavail) * bsize
+		used := size - free
+
+		var usePercent int
+		if size > 0 {
+			usePercent = int(float64(used) / float64(size) * 100.0)
+		}
+
+		readOnly := false
+		for _, opt := range strings.Split(fields[3], ",") {
+			if opt == "ro" {
+				readOnly = true
+			}
+		}
+
+		infos = append(infos, DiskInfo{
+			Device:      device,
+			MountPoint:  mountPoint,
+			FSType:      fsType,
+			Label:       deviceLabel(device),
+			Size:        size,
+			Used:        used,
+			Available:   avail,
+			UsePercent:  usePercent,
+			InodesTotal: int64(stat.Files),
+			InodesUsed:  int64(stat.Files) - int64(stat.Ffree),
+			InodesFree:  int64(stat.Ffree),
+			ReadOnly:    readOnly,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("diskprobe: read /proc/mounts: %w", err)
+	}
+
+	return infos, nil
+}
+
+// unescapeMount undoes the octal escaping /proc/mounts applies to spaces,
+// tabs, and backslashes in mount point paths.
+func unescapeMount(s string) string {
+	if !strings.Contains(s, "\\") {
+		return s
+	}
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+3 < len(s) {
+			if n, err := strconv.ParseInt(s[i+1:i+4], 8, 16); err == nil {
+				sb.WriteByte(byte(n))
+				i += 3
+				continue
+			}
+		}
+		sb.WriteByte(s[i])
+	}
+	return sb.String()
+}
+
+// deviceLabel looks up the filesystem label for device via udev's database,
+// falling back to an empty string when no entry exists (e.g. for virtual
+// devices such as tmpfs).
+func deviceLabel(device string) string {
+	major, minor, ok := deviceNumbers(device)
+	if !ok {
+		return ""
+	}
+	data, err := os.ReadFile(fmt.Sprintf("/run/udev/data/b%d:%d", major, minor))
+	if err != nil {
+		return ""
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if strings.HasPrefix(line, "E:ID_FS_LABEL=") {
+			return strings.TrimPrefix(line, "E:ID_FS_LABEL=")
+		}
+	}
+	return ""
+}
+
+// deviceNumbers stats device and extracts its major/minor numbers.
+func deviceNumbers(device string) (major, minor uint32, ok bool) {
+	var st syscall.Stat_t
+	if err := syscall.Stat(device, &st); err != nil {
+		return 0, 0, false
+	}
+	dev := uint64(st.Rdev)
+	return uint32(dev >> 8 & 0xfff), uint32(dev&0xff | (dev >> 12 & 0xfff00)), true
+}
@@ -0,0 +1,15 @@
+//go:build !linux && !darwin && !windows
+
+package diskprobe
+
+import "fmt"
+
+type unsupportedProber struct{}
+
+func newPlatformProber() Prober {
+	return unsupportedProber{}
+}
+
+func (unsupportedProber) Probe(opts Options) ([]DiskInfo, error) {
+	return nil, fmt.Errorf("diskprobe: unsupported platform")
+}
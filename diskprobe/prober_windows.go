@@ -0,0 +1,73 @@
+//go:build windows
+
+package diskprobe
+
+import (
+	"fmt"
+
+	"golang.org/x/sys/windows"
+)
+
+type windowsProber struct{}
+
+func newPlatformProber() Prober {
+	return windowsProber{}
+}
+
+// Probe enumerates drive letters via GetLogicalDrives and reads each one's
+// capacity with GetDiskFreeSpaceExW and metadata with GetVolumeInformationW.
+func (windowsProber) Probe(opts Options) ([]DiskInfo, error) {
+	mask, err := windows.GetLogicalDrives()
+	if err != nil {
+		return nil, fmt.Errorf("diskprobe: GetLogicalDrives: %w", err)
+	}
+
+	var infos []DiskInfo
+	for i := 0; i < 26; i++ {
+		if mask&(1<<uint(i)) == 0 {
+			continue
+		}
+		root := fmt.Sprintf("%c:\\", 'A'+i)
+		rootPtr, err := windows.UTF16PtrFromString(root)
+		if err != nil {
+			continue
+		}
+
+		driveType := windows.GetDriveType(rootPtr)
+		if driveType != windows.DRIVE_FIXED && driveType != windows.DRIVE_REMOVABLE {
+			continue
+		}
+
+		var freeBytesAvail, totalBytes, totalFreeBytes uint64
+		if err := windows.GetDiskFreeSpaceEx(rootPtr, &freeBytesAvail, &totalBytes, &totalFreeBytes); err != nil {
+			continue
+		}
+
+		var label, fsType [windows.MAX_PATH + 1]uint16
+		var serial, maxComponentLen, fsFlags uint32
+		_ = windows.GetVolumeInformation(rootPtr, &label[0], uint32(len(label)), &serial, &maxComponentLen, &fsFlags, &fsType[0], uint32(len(fsType)))
+
+		size := int64(totalBytes)
+		avail := int64(freeBytesAvail)
+		used := size - int64(totalFreeBytes)
+
+		var usePercent int
+		if size > 0 {
+			usePercent = int(float64(used) / float64(size) * 100.0)
+		}
+
+		infos = append(infos, DiskInfo{
+			Device:     root,
+			MountPoint: root,
+			FSType:     windows.UTF16ToString(fsType[:]),
+			Label:      windows.UTF16ToString(label[:]),
+			Size:       size,
+			Used:       used,
+			Available:  avail,
+			UsePercent: usePercent,
+			ReadOnly:   fsFlags&windows.FILE_READ_ONLY_VOLUME != 0,
+		})
+	}
+
+	return infos, nil
+}
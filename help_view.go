@@ -0,0 +1,23 @@
+package main
+
+import "github.com/charmbracelet/lipgloss"
+
+var helpTitleStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#89B4FA")).Bold(true)
+
+// renderHelp draws the ? overlay listing every keybinding.
+func (m Model) renderHelp() string {
+	return helpTitleStyle.Render("Keybindings") + "\n\n" +
+		"  ↑/k, ↓/j     move cursor\n" +
+		"  s            cycle sort column\n" +
+		"  r            reverse sort direction\n" +
+		"  /            filter by mountpoint/fstype substring\n" +
+		"  a            toggle pseudo filesystems\n" +
+		"  i            toggle inode usage view\n" +
+		"  p            pause/resume auto-refresh (--watch)\n" +
+		"  space        force an immediate refresh (--watch)\n" +
+		"  enter        drill into selected mount point\n" +
+		"  esc          back / clear filter\n" +
+		"  ?            toggle this help\n" +
+		"  q, ctrl+c    quit\n\n" +
+		dimStyle.Render("press any key to return")
+}
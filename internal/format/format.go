@@ -0,0 +1,201 @@
+// Package format centralizes the human-readable rendering of byte counts,
+// percentages, durations, and inode counts so every view in the program
+// agrees on units and precision.
+package format
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Prefix selects the unit scale FormatBytes renders a byte count in.
+type Prefix int
+
+const (
+	// PrefixAuto picks the largest IEC prefix that keeps the value >= 1.
+	PrefixAuto Prefix = iota
+	// PrefixAutoSI picks the largest SI prefix that keeps the value >= 1.
+	PrefixAutoSI
+
+	// IEC (1024-based) prefixes.
+	PrefixKibi
+	PrefixMebi
+	PrefixGibi
+	PrefixTebi
+	PrefixPebi
+
+	// SI (1000-based) prefixes.
+	PrefixKilo
+	PrefixMega
+	PrefixGiga
+	PrefixTera
+	PrefixPeta
+)
+
+// ParsePrefix maps a --units flag value to a Prefix. "auto" and "iec"
+// auto-scale per value using IEC (1024-based) units; "si" auto-scales using
+// SI (1000-based) units. The explicit unit names ("kib", "mib", "gib",
+// "tib", "pib", "kb", "mb", "gb", "tb", "pb") pin every value to that unit.
+func ParsePrefix(s string) (Prefix, error) {
+	switch s {
+	case "", "auto", "iec":
+		return PrefixAuto, nil
+	case "si":
+		return PrefixAutoSI, nil
+	case "kib":
+		return PrefixKibi, nil
+	case "mib":
+		return PrefixMebi, nil
+	case "gib":
+		return PrefixGibi, nil
+	case "tib":
+		return PrefixTebi, nil
+	case "pib":
+		return PrefixPebi, nil
+	case "kb":
+		return PrefixKilo, nil
+	case "mb":
+		return PrefixMega, nil
+	case "gb":
+		return PrefixGiga, nil
+	case "tb":
+		return PrefixTera, nil
+	case "pb":
+		return PrefixPeta, nil
+	default:
+		return PrefixAuto, fmt.Errorf("format: unknown units %q", s)
+	}
+}
+
+type unit struct {
+	scale  float64
+	suffix string
+}
+
+var units = map[Prefix]unit{
+	PrefixKibi: {1 << 10, "KiB"},
+	PrefixMebi: {1 << 20, "MiB"},
+	PrefixGibi: {1 << 30, "GiB"},
+	PrefixTebi: {1 << 40, "TiB"},
+	PrefixPebi: {1 << 50, "PiB"},
+	PrefixKilo: {1e3, "KB"},
+	PrefixMega: {1e6, "MB"},
+	PrefixGiga: {1e9, "GB"},
+	PrefixTera: {1e12, "TB"},
+	PrefixPeta: {1e15, "PB"},
+}
+
+// autoSteps is walked largest-first to find the biggest IEC unit that keeps
+// the rendered value >= 1.
+var autoSteps = []Prefix{PrefixPebi, PrefixTebi, PrefixGibi, PrefixMebi, PrefixKibi}
+
+// autoStepsSI is the SI (1000-based) equivalent of autoSteps.
+var autoStepsSI = []Prefix{PrefixPeta, PrefixTera, PrefixGiga, PrefixMega, PrefixKilo}
+
+// FormatBytes renders n bytes under the given Prefix. PrefixAuto and
+// PrefixAutoSI each auto-scale per value, choosing the largest IEC
+// (KiB/MiB/GiB/...) or SI (KB/MB/GB/...) unit that keeps the value >= 1,
+// falling back to plain bytes for small values. Any other Prefix pins the
+// rendering to that exact unit.
+func FormatBytes(n uint64, prefix Prefix) string {
+	switch prefix {
+	case PrefixAuto:
+		return formatAuto(n, autoSteps)
+	case PrefixAutoSI:
+		return formatAuto(n, autoStepsSI)
+	}
+
+	u, ok := units[prefix]
+	if !ok {
+		return fmt.Sprintf("%d B", n)
+	}
+	return fmt.Sprintf("%.2f %s", float64(n)/u.scale, u.suffix)
+}
+
+// formatAuto renders n using the largest unit in steps (ordered
+// largest-first) that keeps the value >= 1.
+func formatAuto(n uint64, steps []Prefix) string {
+	for _, p := range steps {
+		u := units[p]
+		if float64(n) >= u.scale {
+			return fmt.Sprintf("%.2f %s", float64(n)/u.scale, u.suffix)
+		}
+	}
+	return fmt.Sprintf("%d B", n)
+}
+
+// parseByteUnits maps the suffix a human types (e.g. in --min-size=1GiB) to
+// its scale in bytes. Longest suffixes are matched first by ParseBytes.
+var parseByteUnits = []struct {
+	suffix string
+	scale  float64
+}{
+	{"kib", 1 << 10}, {"mib", 1 << 20}, {"gib", 1 << 30}, {"tib", 1 << 40}, {"pib", 1 << 50},
+	{"kb", 1e3}, {"mb", 1e6}, {"gb", 1e9}, {"tb", 1e12}, {"pb", 1e15},
+	{"k", 1 << 10}, {"m", 1 << 20}, {"g", 1 << 30}, {"t", 1 << 40}, {"p", 1 << 50},
+	{"b", 1},
+}
+
+// ParseBytes parses a human-entered size like "1GiB", "500MB", or "2048"
+// (plain bytes) into a byte count.
+func ParseBytes(s string) (uint64, error) {
+	s = strings.TrimSpace(s)
+	lower := strings.ToLower(s)
+	for _, u := range parseByteUnits {
+		if strings.HasSuffix(lower, u.suffix) {
+			numPart := strings.TrimSpace(s[:len(s)-len(u.suffix)])
+			if numPart == "" {
+				continue
+			}
+			n, err := strconv.ParseFloat(numPart, 64)
+			if err != nil {
+				return 0, fmt.Errorf("format: invalid size %q: %w", s, err)
+			}
+			return uint64(n * u.scale), nil
+		}
+	}
+
+	// No unit suffix at all (e.g. "2048" or "0") - treat as a plain byte count.
+	n, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("format: invalid size %q: no recognized unit suffix", s)
+	}
+	return uint64(n), nil
+}
+
+// FormatPercent renders a 0-100 percentage with no decimal places.
+func FormatPercent(p float64) string {
+	return fmt.Sprintf("%.0f%%", p)
+}
+
+// FormatDuration renders d at whatever resolution keeps it readable: whole
+// seconds below a minute, minutes and seconds below an hour, otherwise
+// hours and minutes.
+func FormatDuration(d time.Duration) string {
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm%ds", int(d.Minutes()), int(d.Seconds())%60)
+	default:
+		return fmt.Sprintf("%dh%dm", int(d.Hours()), int(d.Minutes())%60)
+	}
+}
+
+// FormatInodes renders an inode count with the same auto-scaling rules as
+// FormatBytes, but using plain SI-style magnitude suffixes (K/M/G) since
+// inode counts aren't byte quantities.
+func FormatInodes(n int64) string {
+	switch {
+	case n >= 1e9:
+		return fmt.Sprintf("%.2fG", float64(n)/1e9)
+	case n >= 1e6:
+		return fmt.Sprintf("%.2fM", float64(n)/1e6)
+	case n >= 1e3:
+		return fmt.Sprintf("%.2fK", float64(n)/1e3)
+	default:
+		return fmt.Sprintf("%d", n)
+	}
+}
@@ -0,0 +1,134 @@
+package format
+
+import "testing"
+
+func TestFormatBytesAuto(t *testing.T) {
+	cases := []struct {
+		in   uint64
+		want string
+	}{
+		{0, "0 B"},
+		{1023, "1023 B"},
+		{1024, "1.00 KiB"},
+		{999999, "976.56 KiB"},
+		{1000, "1000 B"},
+		{1 << 20, "1.00 MiB"},
+		{1 << 30, "1.00 GiB"},
+		{1 << 40, "1.00 TiB"},
+		{5 * (1 << 40), "5.00 TiB"},
+	}
+
+	for _, c := range cases {
+		if got := FormatBytes(c.in, PrefixAuto); got != c.want {
+			t.Errorf("FormatBytes(%d, PrefixAuto) = %q, want %q", c.in, got, c.want)
+		}
+	}
+}
+
+func TestFormatBytesAutoSI(t *testing.T) {
+	cases := []struct {
+		in   uint64
+		want string
+	}{
+		{0, "0 B"},
+		{999, "999 B"},
+		{1000, "1.00 KB"},
+		{500_000_000, "500.00 MB"},
+		{2_000, "2.00 KB"},
+		{1_000_000_000, "1.00 GB"},
+	}
+
+	for _, c := range cases {
+		if got := FormatBytes(c.in, PrefixAutoSI); got != c.want {
+			t.Errorf("FormatBytes(%d, PrefixAutoSI) = %q, want %q", c.in, got, c.want)
+		}
+	}
+}
+
+func TestFormatBytesExplicitPrefix(t *testing.T) {
+	cases := []struct {
+		in     uint64
+		prefix Prefix
+		want   string
+	}{
+		{1 << 30, PrefixKibi, "1048576.00 KiB"},
+		{1000, PrefixKilo, "1.00 KB"},
+		{1024, PrefixKilo, "1.02 KB"},
+		{1 << 40, PrefixGibi, "1024.00 GiB"},
+	}
+
+	for _, c := range cases {
+		if got := FormatBytes(c.in, c.prefix); got != c.want {
+			t.Errorf("FormatBytes(%d, %v) = %q, want %q", c.in, c.prefix, got, c.want)
+		}
+	}
+}
+
+func TestParsePrefix(t *testing.T) {
+	cases := map[string]Prefix{
+		"":     PrefixAuto,
+		"auto": PrefixAuto,
+		"iec":  PrefixAuto,
+		"si":   PrefixAutoSI,
+		"mib":  PrefixMebi,
+		"tb":   PrefixTera,
+	}
+	for in, want := range cases {
+		got, err := ParsePrefix(in)
+		if err != nil {
+			t.Fatalf("ParsePrefix(%q) returned error: %v", in, err)
+		}
+		if got != want {
+			t.Errorf("ParsePrefix(%q) = %v, want %v", in, got, want)
+		}
+	}
+
+	if _, err := ParsePrefix("bogus"); err == nil {
+		t.Error("ParsePrefix(\"bogus\") expected an error, got nil")
+	}
+}
+
+func TestParseBytes(t *testing.T) {
+	cases := []struct {
+		in   string
+		want uint64
+	}{
+		{"2048", 2048},
+		{"1GiB", 1 << 30},
+		{"500MB", 500 * 1e6},
+		{"1.5GiB", uint64(1.5 * (1 << 30))},
+		{"10k", 10 * (1 << 10)},
+	}
+
+	for _, c := range cases {
+		got, err := ParseBytes(c.in)
+		if err != nil {
+			t.Fatalf("ParseBytes(%q) returned error: %v", c.in, err)
+		}
+		if got != c.want {
+			t.Errorf("ParseBytes(%q) = %d, want %d", c.in, got, c.want)
+		}
+	}
+
+	if _, err := ParseBytes("bogus"); err == nil {
+		t.Error("ParseBytes(\"bogus\") expected an error, got nil")
+	}
+}
+
+func TestFormatInodes(t *testing.T) {
+	cases := []struct {
+		in   int64
+		want string
+	}{
+		{0, "0"},
+		{999, "999"},
+		{1000, "1.00K"},
+		{1500000, "1.50M"},
+	}
+
+	for _, c := range cases {
+		if got := FormatInodes(c.in); got != c.want {
+			t.Errorf("FormatInodes(%d) = %q, want %q", c.in, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/dahead/space/diskprobe"
+	"github.com/dahead/space/internal/format"
+)
+
+var (
+	headerStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("#89B4FA")).Bold(true)
+	fsStyle      = lipgloss.NewStyle().Foreground(lipgloss.Color("#89B4FA"))
+	sizeStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("#94E2D5"))
+	mountStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("#A6E3A1"))
+	usedStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("#F38BA8"))
+	availStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("#A6E3A1"))
+	percentStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#FAB387"))
+	cursorStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("#A6E3A1")).Bold(true)
+	dimStyle     = lipgloss.NewStyle().Foreground(lipgloss.Color("#6C7086"))
+	warnRowStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#FAB387"))
+	critRowStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#F38BA8")).Blink(true)
+)
+
+const listRowFormat = "%-2s%-2s%-20s %10s %10s %10s %6s %-8s %s\n"
+
+// renderList draws the sortable, filterable table of mounted filesystems.
+func (m Model) renderList() string {
+	if m.err != nil {
+		return fmt.Sprintf("Error: %v\n", m.err)
+	}
+	if len(m.diskInfos) == 0 {
+		return "Loading disk information...\n"
+	}
+
+	rows := m.visibleRows()
+
+	var sb strings.Builder
+	sb.WriteString(headerStyle.Render(fmt.Sprintf(listRowFormat, "", "", "Filesystem", "Size", "Used", "Avail", "Use%", "FSType", "Mounted on")))
+
+	for i, di := range rows {
+		cursor := "  "
+		if i == m.cursor {
+			cursor = "> "
+		}
+
+		size := format.FormatBytes(uint64(di.Size), m.units)
+		used := format.FormatBytes(uint64(di.Used), m.units)
+		avail := format.FormatBytes(uint64(di.Available), m.units)
+		pct := format.FormatPercent(float64(di.UsePercent))
+
+		switch m.thresholds.status(di) {
+		case statusCrit:
+			line := fmt.Sprintf(listRowFormat, "✖ ", cursor, truncate(di.Device, 20), size, used, avail, pct, di.FSType, di.MountPoint+m.deltaSuffix(di))
+			sb.WriteString(critRowStyle.Render(line))
+			if m.showInodes {
+				sb.WriteString(inodeLine(di))
+			}
+			continue
+		case statusWarn:
+			line := fmt.Sprintf(listRowFormat, "⚠ ", cursor, truncate(di.Device, 20), size, used, avail, pct, di.FSType, di.MountPoint+m.deltaSuffix(di))
+			sb.WriteString(warnRowStyle.Render(line))
+			if m.showInodes {
+				sb.WriteString(inodeLine(di))
+			}
+			continue
+		}
+
+		sb.WriteString(fmt.Sprintf(listRowFormat,
+			"",
+			cursorStyle.Render(cursor),
+			fsStyle.Render(truncate(di.Device, 20)),
+			sizeStyle.Render(size),
+			usedStyle.Render(used),
+			availStyle.Render(avail),
+			percentStyle.Render(pct),
+			dimStyle.Render(di.FSType),
+			mountStyle.Render(di.MountPoint)+m.deltaSuffix(di),
+		))
+		if m.showInodes {
+			sb.WriteString(inodeLine(di))
+		}
+	}
+
+	sb.WriteString("\n")
+	sb.WriteString(statusLine(m, rows))
+	return sb.String()
+}
+
+// statusLine summarizes the current sort/filter/show-all state and the
+// active keybindings, shown below the table.
+func statusLine(m Model, rows []diskprobe.DiskInfo) string {
+	var sb strings.Builder
+
+	if m.filtering {
+		sb.WriteString(fmt.Sprintf("/%s█\n", m.filter))
+	} else if m.filter != "" {
+		sb.WriteString(dimStyle.Render(fmt.Sprintf("filter: %q (press / to edit, esc to clear)\n", m.filter)))
+	}
+
+	sort := fmt.Sprintf("sort: %s", m.sortBy)
+	if m.sortDesc {
+		sort += " (desc)"
+	}
+	all := "hidden"
+	if m.showAll {
+		all = "shown"
+	}
+
+	watch := ""
+	if m.watch {
+		watch = fmt.Sprintf(" · watching every %s", m.interval)
+		if m.paused {
+			watch += " (paused)"
+		}
+	}
+
+	sb.WriteString(dimStyle.Render(fmt.Sprintf("%d rows · %s · pseudo filesystems %s%s · press ? for help\n", len(rows), sort, all, watch)))
+
+	return sb.String()
+}
+
+const inodeBarWidth = 20
+
+// inodeLine renders a second, indented line under a row showing its inode
+// usage as a bar and percentage — disk space and inode space can run out
+// independently, and this is the only place that surfaces the latter.
+func inodeLine(di diskprobe.DiskInfo) string {
+	if di.InodesTotal == 0 {
+		return ""
+	}
+
+	frac := float64(di.InodesUsed) / float64(di.InodesTotal)
+	filled := int(frac * inodeBarWidth)
+	bar := usedStyle.Render(strings.Repeat("█", filled)) + strings.Repeat("░", inodeBarWidth-filled)
+
+	return fmt.Sprintf("    inodes [%s] %s (%s / %s)\n",
+		bar,
+		format.FormatPercent(frac*100),
+		format.FormatInodes(di.InodesUsed),
+		format.FormatInodes(di.InodesTotal),
+	)
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	if n <= 1 {
+		return s[:n]
+	}
+	return s[:n-1] + "…"
+}
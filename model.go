@@ -0,0 +1,144 @@
+package main
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbletea"
+
+	"github.com/dahead/space/diskprobe"
+	"github.com/dahead/space/internal/format"
+)
+
+// viewState identifies which screen the Model is currently rendering.
+type viewState int
+
+const (
+	viewList viewState = iota
+	viewDetail
+	viewHelp
+)
+
+// sortKey identifies the column rows are currently sorted by.
+type sortKey int
+
+const (
+	sortFilesystem sortKey = iota
+	sortSize
+	sortUsed
+	sortAvail
+	sortUsePercent
+	sortFSType
+	sortMount
+	sortKeyCount // sentinel: number of sort keys, for cycling
+)
+
+func (k sortKey) String() string {
+	switch k {
+	case sortFilesystem:
+		return "Filesystem"
+	case sortSize:
+		return "Size"
+	case sortUsed:
+		return "Used"
+	case sortAvail:
+		return "Avail"
+	case sortUsePercent:
+		return "Use%"
+	case sortFSType:
+		return "FSType"
+	case sortMount:
+		return "Mount"
+	default:
+		return "?"
+	}
+}
+
+// Model represents the application state
+type Model struct {
+	diskInfos  []diskprobe.DiskInfo
+	units      format.Prefix
+	thresholds thresholds
+	err        error
+
+	state     viewState
+	prevState viewState
+
+	sortBy     sortKey
+	sortDesc   bool
+	showAll    bool
+	showInodes bool
+
+	filtering bool
+	filter    string
+
+	cursor int
+	detail *detailModel
+
+	watch    bool
+	interval time.Duration
+	paused   bool
+	previous map[string]diskprobe.DiskInfo
+}
+
+// Init initializes the model
+func (m Model) Init() tea.Cmd {
+	if m.watch {
+		return tea.Batch(getDiskInfoCmd(m.showAll), tickCmd(m.interval))
+	}
+	return getDiskInfoCmd(m.showAll)
+}
+
+// getDiskInfoCmd probes the host's mounted filesystems via diskprobe
+func getDiskInfoCmd(showAll bool) tea.Cmd {
+	return func() tea.Msg {
+		infos, err := diskprobe.New().Probe(diskprobe.Options{IncludePseudo: showAll})
+		if err != nil {
+			return err
+		}
+		return infos
+	}
+}
+
+// visibleRows returns diskInfos filtered by the current search term and
+// sorted by the current sort key/direction.
+func (m Model) visibleRows() []diskprobe.DiskInfo {
+	rows := make([]diskprobe.DiskInfo, 0, len(m.diskInfos))
+	needle := strings.ToLower(m.filter)
+	for _, di := range m.diskInfos {
+		if needle != "" &&
+			!strings.Contains(strings.ToLower(di.MountPoint), needle) &&
+			!strings.Contains(strings.ToLower(di.FSType), needle) {
+			continue
+		}
+		rows = append(rows, di)
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		a, b := rows[i], rows[j]
+		var less bool
+		switch m.sortBy {
+		case sortSize:
+			less = a.Size < b.Size
+		case sortUsed:
+			less = a.Used < b.Used
+		case sortAvail:
+			less = a.Available < b.Available
+		case sortUsePercent:
+			less = a.UsePercent < b.UsePercent
+		case sortFSType:
+			less = a.FSType < b.FSType
+		case sortMount:
+			less = a.MountPoint < b.MountPoint
+		default: // sortFilesystem
+			less = a.Device < b.Device
+		}
+		if m.sortDesc {
+			return !less
+		}
+		return less
+	})
+
+	return rows
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/dahead/space/diskprobe"
+	"github.com/dahead/space/internal/format"
+)
+
+// diskInfoJSON adds the float fields machine consumers want (full precision
+// ratios) on top of diskprobe.DiskInfo's already-rounded integer percent.
+type diskInfoJSON struct {
+	diskprobe.DiskInfo
+	UsedPercentFloat float64 `json:"used_percent_float"`
+}
+
+// runOutput probes disk usage once, prints it in the requested
+// machine-readable or plain-table format, and returns the worst alertStatus
+// across all filesystems so main can exit with the matching Nagios-style
+// code. Used outside the TUI (cron jobs, pipes, node-exporter textfile
+// collectors, ...).
+func runOutput(w io.Writer, outputFormat string, units format.Prefix, showAll, showInodes bool, th thresholds) (alertStatus, error) {
+	infos, err := diskprobe.New().Probe(diskprobe.Options{IncludePseudo: showAll})
+	if err != nil {
+		return statusCrit, err
+	}
+
+	switch outputFormat {
+	case "json":
+		return th.worst(infos), writeJSON(w, infos)
+	case "csv":
+		return th.worst(infos), writeCSV(w, infos)
+	case "prom":
+		return th.worst(infos), writeProm(w, infos)
+	case "table":
+		return th.worst(infos), writeTable(w, infos, units, showInodes)
+	default:
+		return statusCrit, fmt.Errorf("space: unknown --output format %q", outputFormat)
+	}
+}
+
+func usedPercentFloat(di diskprobe.DiskInfo) float64 {
+	if di.Size == 0 {
+		return 0
+	}
+	return float64(di.Used) / float64(di.Size) * 100.0
+}
+
+func writeJSON(w io.Writer, infos []diskprobe.DiskInfo) error {
+	out := make([]diskInfoJSON, len(infos))
+	for i, di := range infos {
+		out[i] = diskInfoJSON{DiskInfo: di, UsedPercentFloat: usedPercentFloat(di)}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
+
+func writeCSV(w io.Writer, infos []diskprobe.DiskInfo) error {
+	cw := csv.NewWriter(w)
+	header := []string{"device", "mountpoint", "fstype", "size", "used", "available", "used_percent", "inodes_total", "inodes_used", "inodes_free", "read_only"}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for _, di := range infos {
+		row := []string{
+			di.Device,
+			di.MountPoint,
+			di.FSType,
+			strconv.FormatInt(di.Size, 10),
+			strconv.FormatInt(di.Used, 10),
+			strconv.FormatInt(di.Available, 10),
+			strconv.Itoa(di.UsePercent),
+			strconv.FormatInt(di.InodesTotal, 10),
+			strconv.FormatInt(di.InodesUsed, 10),
+			strconv.FormatInt(di.InodesFree, 10),
+			strconv.FormatBool(di.ReadOnly),
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// writeProm emits Prometheus text-exposition format, suitable for use as a
+// node_exporter textfile-collector target.
+func writeProm(w io.Writer, infos []diskprobe.DiskInfo) error {
+	metrics := []struct {
+		name string
+		help string
+		val  func(diskprobe.DiskInfo) float64
+	}{
+		{"space_disk_size_bytes", "Total size of the filesystem in bytes.", func(di diskprobe.DiskInfo) float64 { return float64(di.Size) }},
+		{"space_disk_used_bytes", "Used size of the filesystem in bytes.", func(di diskprobe.DiskInfo) float64 { return float64(di.Used) }},
+		{"space_disk_avail_bytes", "Available size of the filesystem in bytes.", func(di diskprobe.DiskInfo) float64 { return float64(di.Available) }},
+		{"space_disk_used_ratio", "Fraction of the filesystem in use, 0-1.", func(di diskprobe.DiskInfo) float64 { return usedPercentFloat(di) / 100.0 }},
+	}
+
+	for _, metric := range metrics {
+		if _, err := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s gauge\n", metric.name, metric.help, metric.name); err != nil {
+			return err
+		}
+		for _, di := range infos {
+			_, err := fmt.Fprintf(w, "%s{device=%q,mountpoint=%q,fstype=%q} %v\n",
+				metric.name, di.Device, di.MountPoint, di.FSType, metric.val(di))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func writeTable(w io.Writer, infos []diskprobe.DiskInfo, units format.Prefix, showInodes bool) error {
+	_, err := fmt.Fprintf(w, listRowFormat, "", "", "Filesystem", "Size", "Used", "Avail", "Use%", "FSType", "Mounted on")
+	if err != nil {
+		return err
+	}
+	for _, di := range infos {
+		_, err := fmt.Fprintf(w, listRowFormat,
+			"",
+			"",
+			di.Device,
+			format.FormatBytes(uint64(di.Size), units),
+			format.FormatBytes(uint64(di.Used), units),
+			format.FormatBytes(uint64(di.Available), units),
+			format.FormatPercent(float64(di.UsePercent)),
+			di.FSType,
+			di.MountPoint,
+		)
+		if err != nil {
+			return err
+		}
+		if showInodes {
+			if _, err := fmt.Fprint(w, inodeLine(di)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dahead/space/diskprobe"
+)
+
+// alertStatus mirrors the Nagios plugin exit-code convention so `space` can
+// be dropped directly into monitoring pipelines.
+type alertStatus int
+
+const (
+	statusOK alertStatus = iota
+	statusWarn
+	statusCrit
+)
+
+// ExitCode returns the process exit code matching Nagios conventions
+// (0=OK, 1=WARN, 2=CRIT).
+func (s alertStatus) ExitCode() int { return int(s) }
+
+func (s alertStatus) String() string {
+	switch s {
+	case statusWarn:
+		return "WARN"
+	case statusCrit:
+		return "CRIT"
+	default:
+		return "OK"
+	}
+}
+
+// thresholdOverride holds the warn/crit percentages for one mount point.
+type thresholdOverride struct {
+	warn, crit int
+}
+
+// thresholds evaluates a filesystem's use percentage against the global
+// --warn/--crit percentages, or a --threshold override for its mount point.
+type thresholds struct {
+	warn, crit int
+	perMount   map[string]thresholdOverride
+	minSize    uint64
+}
+
+// thresholdFlags collects repeated --threshold=/mount=WARN:CRIT flags via
+// flag.Var.
+type thresholdFlags struct {
+	overrides map[string]thresholdOverride
+}
+
+func (f *thresholdFlags) String() string {
+	return ""
+}
+
+// Set parses one --threshold=/mount=WARN:CRIT occurrence.
+func (f *thresholdFlags) Set(value string) error {
+	mount, pair, ok := strings.Cut(value, "=")
+	if !ok {
+		return fmt.Errorf("space: invalid --threshold %q, want /mount=WARN:CRIT", value)
+	}
+	warnStr, critStr, ok := strings.Cut(pair, ":")
+	if !ok {
+		return fmt.Errorf("space: invalid --threshold %q, want /mount=WARN:CRIT", value)
+	}
+	warn, err := strconv.Atoi(warnStr)
+	if err != nil {
+		return fmt.Errorf("space: invalid --threshold %q: %w", value, err)
+	}
+	crit, err := strconv.Atoi(critStr)
+	if err != nil {
+		return fmt.Errorf("space: invalid --threshold %q: %w", value, err)
+	}
+
+	if f.overrides == nil {
+		f.overrides = make(map[string]thresholdOverride)
+	}
+	f.overrides[mount] = thresholdOverride{warn: warn, crit: crit}
+	return nil
+}
+
+// forMount returns the effective warn/crit percentages for mount, falling
+// back to the global thresholds when there's no override.
+func (t thresholds) forMount(mount string) (warn, crit int) {
+	if o, ok := t.perMount[mount]; ok {
+		return o.warn, o.crit
+	}
+	return t.warn, t.crit
+}
+
+// status classifies a single filesystem's use percentage.
+func (t thresholds) status(di diskprobe.DiskInfo) alertStatus {
+	if uint64(di.Size) < t.minSize {
+		return statusOK
+	}
+	warn, crit := t.forMount(di.MountPoint)
+	switch {
+	case di.UsePercent >= crit:
+		return statusCrit
+	case di.UsePercent >= warn:
+		return statusWarn
+	default:
+		return statusOK
+	}
+}
+
+// worst returns the most severe status across every filesystem, respecting
+// --min-size exclusions.
+func (t thresholds) worst(infos []diskprobe.DiskInfo) alertStatus {
+	worst := statusOK
+	for _, di := range infos {
+		if s := t.status(di); s > worst {
+			worst = s
+		}
+	}
+	return worst
+}
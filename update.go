@@ -0,0 +1,139 @@
+package main
+
+import (
+	"github.com/charmbracelet/bubbletea"
+
+	"github.com/dahead/space/diskprobe"
+)
+
+// Update handles events and updates the model
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	// Probe results and watch ticks are handled whichever view is showing,
+	// so --watch keeps refreshing behind the detail and help views.
+	switch msg.(type) {
+	case []diskprobe.DiskInfo, refreshMsg:
+		return m.updateList(msg)
+	}
+
+	switch m.state {
+	case viewDetail:
+		return m.updateDetail(msg)
+	case viewHelp:
+		return m.updateHelp(msg)
+	default:
+		return m.updateList(msg)
+	}
+}
+
+// updateHelp closes the help overlay on any key press, returning to
+// whichever view was active before ? was pressed.
+func (m Model) updateHelp(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg.(type) {
+	case tea.KeyMsg:
+		m.state = m.prevState
+	}
+	return m, nil
+}
+
+// updateList handles key events while viewList is active.
+func (m Model) updateList(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case []diskprobe.DiskInfo:
+		m.snapshotPrevious()
+		m.diskInfos = msg
+		m.err = nil
+		if m.cursor >= len(m.diskInfos) {
+			m.cursor = 0
+		}
+		return m, nil
+	case error:
+		m.err = msg
+		return m, nil
+	case refreshMsg:
+		if m.paused {
+			return m, tickCmd(m.interval)
+		}
+		return m, tea.Batch(getDiskInfoCmd(m.showAll), tickCmd(m.interval))
+	case tea.KeyMsg:
+		if m.filtering {
+			return m.updateFilterInput(msg)
+		}
+		return m.updateListKey(msg)
+	}
+	return m, nil
+}
+
+// updateFilterInput handles keystrokes while typing a filter after '/'.
+func (m Model) updateFilterInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "enter":
+		m.filtering = false
+	case "esc":
+		m.filtering = false
+		m.filter = ""
+	case "backspace":
+		if len(m.filter) > 0 {
+			m.filter = m.filter[:len(m.filter)-1]
+		}
+	case "ctrl+c":
+		return m, tea.Quit
+	default:
+		if len(msg.Runes) > 0 {
+			m.filter += string(msg.Runes)
+		}
+	}
+	m.cursor = 0
+	return m, nil
+}
+
+// updateListKey handles navigation and view commands while viewList is
+// active and no filter is being typed.
+func (m Model) updateListKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	rows := m.visibleRows()
+
+	switch msg.String() {
+	case "q", "ctrl+c":
+		return m, tea.Quit
+	case "?":
+		m.prevState = m.state
+		m.state = viewHelp
+	case "/":
+		m.filtering = true
+	case "esc":
+		m.filter = ""
+		m.cursor = 0
+	case "up", "k":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case "down", "j":
+		if m.cursor < len(rows)-1 {
+			m.cursor++
+		}
+	case "s":
+		m.sortBy = (m.sortBy + 1) % sortKeyCount
+	case "r":
+		m.sortDesc = !m.sortDesc
+	case "i":
+		m.showInodes = !m.showInodes
+	case "a":
+		m.showAll = !m.showAll
+		return m, getDiskInfoCmd(m.showAll)
+	case "p":
+		if m.watch {
+			m.paused = !m.paused
+		}
+	case " ":
+		if m.watch {
+			return m, getDiskInfoCmd(m.showAll)
+		}
+	case "enter":
+		if m.cursor < len(rows) {
+			detail, cmd := newDetailModel(rows[m.cursor].MountPoint)
+			m.detail = detail
+			m.state = viewDetail
+			return m, cmd
+		}
+	}
+	return m, nil
+}
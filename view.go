@@ -0,0 +1,13 @@
+package main
+
+// View renders the UI
+func (m Model) View() string {
+	switch m.state {
+	case viewDetail:
+		return m.renderDetail()
+	case viewHelp:
+		return m.renderHelp()
+	default:
+		return m.renderList()
+	}
+}
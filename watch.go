@@ -0,0 +1,57 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/dahead/space/diskprobe"
+	"github.com/dahead/space/internal/format"
+)
+
+var (
+	grewStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("#F38BA8"))
+	freedStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#A6E3A1"))
+)
+
+// refreshMsg fires every --interval while --watch is on, triggering a fresh
+// probe and the next tick.
+type refreshMsg struct{}
+
+// tickCmd schedules the next refreshMsg after interval.
+func tickCmd(interval time.Duration) tea.Cmd {
+	return tea.Tick(interval, func(time.Time) tea.Msg { return refreshMsg{} })
+}
+
+// snapshotPrevious records the current diskInfos, keyed by mount point, so
+// the next probe's results can be diffed against them.
+func (m *Model) snapshotPrevious() {
+	if m.previous == nil {
+		m.previous = make(map[string]diskprobe.DiskInfo, len(m.diskInfos))
+	}
+	for _, di := range m.diskInfos {
+		m.previous[di.MountPoint] = di
+	}
+}
+
+// deltaSuffix renders how much a row's Used bytes changed since the last
+// probe, e.g. " +124.00 MiB" in red if it grew or " -124.00 MiB" in green if it
+// freed space. Returns "" outside watch mode or for a mount seen for the
+// first time.
+func (m Model) deltaSuffix(di diskprobe.DiskInfo) string {
+	if !m.watch {
+		return ""
+	}
+	prev, ok := m.previous[di.MountPoint]
+	if !ok || prev.Used == di.Used {
+		return ""
+	}
+
+	delta := di.Used - prev.Used
+	if delta > 0 {
+		return grewStyle.Render(fmt.Sprintf(" +%s", format.FormatBytes(uint64(delta), m.units)))
+	}
+	return freedStyle.Render(fmt.Sprintf(" -%s", format.FormatBytes(uint64(-delta), m.units)))
+}